@@ -0,0 +1,252 @@
+package unittestutils
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// namespacePlaceholder marks the spot in each embedded policy's
+// spec.rules[*].match.resources.namespaces list that LoadPolicy rewrites to
+// the target tenant namespace.
+const namespacePlaceholder = "__TENANT_NAMESPACE__"
+
+// Policies is a registry of built-in Kyverno ClusterPolicy fixtures, keyed
+// by policy name, covering the common Pod Security Standards rules from the
+// Kyverno policies library (https://kyverno.io/policies/). Tests use these
+// together with LoadPolicy to exercise HNC/tenant admission behavior
+// against more than just disallow-privileged.
+var Policies = map[string]string{
+	"disallow-privileged":            disallowPrivilegedPolicy,
+	"disallow-privilege-escalation":  disallowPrivilegeEscalationPolicy,
+	"disallow-host-namespaces":       disallowHostNamespacesPolicy,
+	"disallow-host-path":             disallowHostPathPolicy,
+	"require-run-as-non-root":        requireRunAsNonRootPolicy,
+	"restrict-secrets-from-env-vars": restrictSecretsFromEnvVarsPolicy,
+	"restrict-secrets-from-volumes":  restrictSecretsFromVolumesPolicy,
+}
+
+const disallowPrivilegedPolicy = `
+apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: disallow-privileged
+spec:
+  validationFailureAction: enforce
+  rules:
+    - name: validate-privileged
+      match:
+        resources:
+          kinds:
+            - Pod
+          namespaces:
+            - __TENANT_NAMESPACE__
+      validate:
+        message: "Privileged mode is not allowed. Set privileged to false"
+        pattern:
+          spec:
+            containers:
+              - =(securityContext):
+                  # https://github.com/kubernetes/api/blob/7dc09db16fb8ff2eee16c65dc066c85ab3abb7ce/core/v1/types.go#L5707-L5711
+                  # k8s default to false
+                  =(privileged): false
+`
+
+const disallowPrivilegeEscalationPolicy = `
+apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: disallow-privilege-escalation
+spec:
+  validationFailureAction: enforce
+  rules:
+    - name: validate-privilege-escalation
+      match:
+        resources:
+          kinds:
+            - Pod
+          namespaces:
+            - __TENANT_NAMESPACE__
+      validate:
+        message: "Privilege escalation is not allowed. Set allowPrivilegeEscalation to false"
+        pattern:
+          spec:
+            containers:
+              - =(securityContext):
+                  =(allowPrivilegeEscalation): false
+`
+
+const disallowHostNamespacesPolicy = `
+apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: disallow-host-namespaces
+spec:
+  validationFailureAction: enforce
+  rules:
+    - name: validate-host-namespaces
+      match:
+        resources:
+          kinds:
+            - Pod
+          namespaces:
+            - __TENANT_NAMESPACE__
+      validate:
+        message: "Sharing the host namespaces is not allowed. hostNetwork, hostIPC and hostPID must all be unset or set to false"
+        pattern:
+          spec:
+            =(hostPID): false
+            =(hostIPC): false
+            =(hostNetwork): false
+`
+
+const disallowHostPathPolicy = `
+apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: disallow-host-path
+spec:
+  validationFailureAction: enforce
+  rules:
+    - name: validate-host-path
+      match:
+        resources:
+          kinds:
+            - Pod
+          namespaces:
+            - __TENANT_NAMESPACE__
+      validate:
+        message: "HostPath volumes are forbidden. The field spec.volumes[*].hostPath must be unset"
+        pattern:
+          spec:
+            =(volumes):
+              - X(hostPath): "null"
+`
+
+const requireRunAsNonRootPolicy = `
+apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: require-run-as-non-root
+spec:
+  validationFailureAction: enforce
+  rules:
+    - name: validate-run-as-non-root
+      match:
+        resources:
+          kinds:
+            - Pod
+          namespaces:
+            - __TENANT_NAMESPACE__
+      validate:
+        message: "Running as root is not allowed. Set runAsNonRoot to true"
+        pattern:
+          spec:
+            =(securityContext):
+              runAsNonRoot: true
+`
+
+const restrictSecretsFromEnvVarsPolicy = `
+apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: restrict-secrets-from-env-vars
+spec:
+  validationFailureAction: enforce
+  rules:
+    - name: validate-secrets-env-vars
+      match:
+        resources:
+          kinds:
+            - Pod
+          namespaces:
+            - __TENANT_NAMESPACE__
+      validate:
+        message: "Secrets must be mounted as volumes, not exposed as environment variables"
+        pattern:
+          spec:
+            containers:
+              - =(env):
+                  - =(valueFrom):
+                      X(secretKeyRef): "null"
+`
+
+const restrictSecretsFromVolumesPolicy = `
+apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: restrict-secrets-from-volumes
+spec:
+  validationFailureAction: enforce
+  rules:
+    - name: validate-secrets-volumes
+      match:
+        resources:
+          kinds:
+            - Pod
+          namespaces:
+            - __TENANT_NAMESPACE__
+      validate:
+        message: "Mounting Secrets as volumes is not allowed"
+        pattern:
+          spec:
+            =(volumes):
+              - X(secret): "null"
+`
+
+// LoadPolicy looks up the built-in policy registered under name in
+// Policies and renders it as an unstructured ClusterPolicy scoped to
+// namespace, substituting namespace into every
+// spec.rules[*].match.resources.namespaces entry. It lets integration
+// tests exercise HNC/tenant behavior against multiple built-in policies
+// instead of hard-coding a single fixture.
+func LoadPolicy(name, namespace string) (*unstructured.Unstructured, error) {
+	raw, ok := Policies[name]
+	if !ok {
+		return nil, fmt.Errorf("unittestutils: no policy registered with name %q", name)
+	}
+
+	obj := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(raw), &obj); err != nil {
+		return nil, fmt.Errorf("unittestutils: failed to parse policy %q: %w", name, err)
+	}
+
+	u := &unstructured.Unstructured{Object: obj}
+
+	rules, found, err := unstructured.NestedSlice(u.Object, "spec", "rules")
+	if err != nil {
+		return nil, fmt.Errorf("unittestutils: failed to read rules for policy %q: %w", name, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("unittestutils: policy %q has no spec.rules", name)
+	}
+
+	for _, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		namespaces, found, err := unstructured.NestedStringSlice(rule, "match", "resources", "namespaces")
+		if err != nil || !found {
+			continue
+		}
+
+		for i, ns := range namespaces {
+			if ns == namespacePlaceholder {
+				namespaces[i] = namespace
+			}
+		}
+
+		if err := unstructured.SetNestedStringSlice(rule, namespaces, "match", "resources", "namespaces"); err != nil {
+			return nil, fmt.Errorf("unittestutils: failed to set namespace for policy %q: %w", name, err)
+		}
+	}
+
+	if err := unstructured.SetNestedSlice(u.Object, rules, "spec", "rules"); err != nil {
+		return nil, fmt.Errorf("unittestutils: failed to write rules for policy %q: %w", name, err)
+	}
+
+	return u, nil
+}
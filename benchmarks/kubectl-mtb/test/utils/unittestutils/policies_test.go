@@ -0,0 +1,56 @@
+package unittestutils
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestLoadPolicy(t *testing.T) {
+	cases := []struct {
+		name      string
+		policy    string
+		namespace string
+		wantErr   bool
+	}{
+		{name: "disallow-privileged", policy: "disallow-privileged", namespace: "tenant1admin"},
+		{name: "restrict-secrets-from-volumes", policy: "restrict-secrets-from-volumes", namespace: "tenant2admin"},
+		{name: "unknown policy", policy: "does-not-exist", namespace: "tenant1admin", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := LoadPolicy(tc.policy, tc.namespace)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("LoadPolicy(%q, %q) = nil error, want error", tc.policy, tc.namespace)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadPolicy(%q, %q) returned error: %v", tc.policy, tc.namespace, err)
+			}
+
+			rules, found, err := unstructured.NestedSlice(u.Object, "spec", "rules")
+			if err != nil || !found {
+				t.Fatalf("spec.rules not found on rendered policy %q: found=%v err=%v", tc.policy, found, err)
+			}
+
+			for _, r := range rules {
+				rule := r.(map[string]interface{})
+				namespaces, found, err := unstructured.NestedStringSlice(rule, "match", "resources", "namespaces")
+				if err != nil || !found {
+					t.Fatalf("rule in policy %q has no match.resources.namespaces: found=%v err=%v", tc.policy, found, err)
+				}
+				for _, ns := range namespaces {
+					if ns == namespacePlaceholder {
+						t.Errorf("policy %q still contains unrendered placeholder %q", tc.policy, namespacePlaceholder)
+					}
+					if ns != tc.namespace {
+						t.Errorf("policy %q namespace = %q, want %q", tc.policy, ns, tc.namespace)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package unittestutils
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// PolicyOptions mirrors the global overrides exposed by the Big Bang
+// kyverno-policies Helm chart (validationFailureAction, exclude,
+// webhookTimeoutSeconds, background) — the chart templates its global
+// exclude into every rule, since Kyverno has no spec-level exclude field.
+// PolicyOptions does the same, letting tests exercise a single built-in
+// policy body under both the audit and enforce paths without duplicating
+// YAML.
+type PolicyOptions struct {
+	// ValidationFailureAction overrides spec.validationFailureAction, e.g.
+	// "audit" or "enforce". Defaults to "enforce" when empty.
+	ValidationFailureAction string
+
+	// Background overrides spec.background. Defaults to true when nil.
+	Background *bool
+
+	// Exclude lists namespaces to exclude from every rule, added under each
+	// spec.rules[].exclude.any[].resources.namespaces, e.g. "kube-system".
+	// Kyverno has no spec-level exclude; it must be set per rule.
+	Exclude []string
+
+	// WebhookTimeoutSeconds overrides spec.webhookTimeoutSeconds.
+	WebhookTimeoutSeconds *int64
+}
+
+// RenderPolicy loads the built-in policy registered under name via
+// LoadPolicy, scoping it to namespace, and then applies opts on top of it,
+// returning the resulting unstructured ClusterPolicy ready to apply.
+func RenderPolicy(name, namespace string, opts PolicyOptions) (*unstructured.Unstructured, error) {
+	u, err := LoadPolicy(name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	action := opts.ValidationFailureAction
+	if action == "" {
+		action = "enforce"
+	}
+	if err := unstructured.SetNestedField(u.Object, action, "spec", "validationFailureAction"); err != nil {
+		return nil, fmt.Errorf("unittestutils: failed to set validationFailureAction for policy %q: %w", name, err)
+	}
+
+	background := true
+	if opts.Background != nil {
+		background = *opts.Background
+	}
+	if err := unstructured.SetNestedField(u.Object, background, "spec", "background"); err != nil {
+		return nil, fmt.Errorf("unittestutils: failed to set background for policy %q: %w", name, err)
+	}
+
+	if opts.WebhookTimeoutSeconds != nil {
+		if err := unstructured.SetNestedField(u.Object, *opts.WebhookTimeoutSeconds, "spec", "webhookTimeoutSeconds"); err != nil {
+			return nil, fmt.Errorf("unittestutils: failed to set webhookTimeoutSeconds for policy %q: %w", name, err)
+		}
+	}
+
+	if len(opts.Exclude) > 0 {
+		excludeAny := make([]interface{}, 0, len(opts.Exclude))
+		for _, ns := range opts.Exclude {
+			excludeAny = append(excludeAny, map[string]interface{}{
+				"resources": map[string]interface{}{
+					"namespaces": []interface{}{ns},
+				},
+			})
+		}
+
+		rules, found, err := unstructured.NestedSlice(u.Object, "spec", "rules")
+		if err != nil {
+			return nil, fmt.Errorf("unittestutils: failed to read rules for policy %q: %w", name, err)
+		}
+		if !found {
+			return nil, fmt.Errorf("unittestutils: policy %q has no spec.rules", name)
+		}
+
+		for _, r := range rules {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if err := unstructured.SetNestedSlice(rule, excludeAny, "exclude", "any"); err != nil {
+				return nil, fmt.Errorf("unittestutils: failed to set exclude for policy %q: %w", name, err)
+			}
+		}
+
+		if err := unstructured.SetNestedSlice(u.Object, rules, "spec", "rules"); err != nil {
+			return nil, fmt.Errorf("unittestutils: failed to write rules for policy %q: %w", name, err)
+		}
+	}
+
+	return u, nil
+}
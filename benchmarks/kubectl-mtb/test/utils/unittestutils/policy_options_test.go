@@ -0,0 +1,87 @@
+package unittestutils
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRenderPolicy(t *testing.T) {
+	background := false
+	timeout := int64(5)
+
+	u, err := RenderPolicy("disallow-privileged", "tenant1admin", PolicyOptions{
+		ValidationFailureAction: "audit",
+		Background:              &background,
+		Exclude:                 []string{"kube-system"},
+		WebhookTimeoutSeconds:   &timeout,
+	})
+	if err != nil {
+		t.Fatalf("RenderPolicy returned error: %v", err)
+	}
+
+	action, _, _ := unstructured.NestedString(u.Object, "spec", "validationFailureAction")
+	if action != "audit" {
+		t.Errorf("validationFailureAction = %q, want %q", action, "audit")
+	}
+
+	gotBackground, _, _ := unstructured.NestedBool(u.Object, "spec", "background")
+	if gotBackground != false {
+		t.Errorf("background = %v, want false", gotBackground)
+	}
+
+	gotTimeout, _, _ := unstructured.NestedInt64(u.Object, "spec", "webhookTimeoutSeconds")
+	if gotTimeout != 5 {
+		t.Errorf("webhookTimeoutSeconds = %d, want 5", gotTimeout)
+	}
+
+	rules, found, _ := unstructured.NestedSlice(u.Object, "spec", "rules")
+	if !found || len(rules) == 0 {
+		t.Fatalf("spec.rules = %v, want at least one rule", rules)
+	}
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+
+		namespaces, found, _ := unstructured.NestedStringSlice(rule, "match", "resources", "namespaces")
+		if !found || len(namespaces) != 1 || namespaces[0] != "tenant1admin" {
+			t.Errorf("rule namespaces = %v, want [tenant1admin]", namespaces)
+		}
+
+		excludeAny, found, _ := unstructured.NestedSlice(rule, "exclude", "any")
+		if !found || len(excludeAny) != 1 {
+			t.Fatalf("rule exclude.any = %v, want one entry", excludeAny)
+		}
+		excludeEntry := excludeAny[0].(map[string]interface{})
+		excludeNS, _, _ := unstructured.NestedStringSlice(excludeEntry, "resources", "namespaces")
+		if len(excludeNS) != 1 || excludeNS[0] != "kube-system" {
+			t.Errorf("rule exclude.any[0].resources.namespaces = %v, want [kube-system]", excludeNS)
+		}
+	}
+
+	if _, found, _ := unstructured.NestedSlice(u.Object, "spec", "exclude"); found {
+		t.Error("spec.exclude should not be set; Kyverno has no spec-level exclude field")
+	}
+}
+
+func TestRenderPolicyDefaults(t *testing.T) {
+	u, err := RenderPolicy("disallow-privileged", "tenant1admin", PolicyOptions{})
+	if err != nil {
+		t.Fatalf("RenderPolicy returned error: %v", err)
+	}
+
+	action, _, _ := unstructured.NestedString(u.Object, "spec", "validationFailureAction")
+	if action != "enforce" {
+		t.Errorf("default validationFailureAction = %q, want %q", action, "enforce")
+	}
+
+	gotBackground, _, _ := unstructured.NestedBool(u.Object, "spec", "background")
+	if gotBackground != true {
+		t.Errorf("default background = %v, want true", gotBackground)
+	}
+}
+
+func TestRenderPolicyUnknownName(t *testing.T) {
+	if _, err := RenderPolicy("does-not-exist", "tenant1admin", PolicyOptions{}); err == nil {
+		t.Fatal("RenderPolicy with unknown name = nil error, want error")
+	}
+}
@@ -0,0 +1,99 @@
+package unittestutils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+)
+
+// policyReportGVR identifies the wgpolicyk8s.io PolicyReport resource that
+// Kyverno writes per-namespace background scan results to.
+var policyReportGVR = schema.GroupVersionResource{
+	Group:    "wgpolicyk8s.io",
+	Version:  "v1alpha2",
+	Resource: "policyreports",
+}
+
+// ReportExpectation describes a rule outcome to look for inside a
+// namespace's PolicyReport: at least Count results for Rule with the given
+// Result (e.g. "pass", "fail", "warn") and, if set, Severity.
+type ReportExpectation struct {
+	Rule     string
+	Result   string
+	Severity string
+	Count    int
+}
+
+// WaitForPolicyReport polls namespace's PolicyReport objects until one of
+// them contains at least expected.Count results for policyName/expected.Rule
+// matching expected.Result (and expected.Severity, if set), or ctx is done.
+// A failed List of the PolicyReports is not retried: it ends the polling and
+// is returned wrapped in the error.
+// expected.Count must be at least 1: a zero Count would be satisfied by the
+// mere existence of a PolicyReport, defeating the purpose of asserting that
+// a specific rule outcome occurred.
+func WaitForPolicyReport(ctx context.Context, client dynamic.Interface, namespace, policyName string, expected ReportExpectation) error {
+	if expected.Count < 1 {
+		return fmt.Errorf("unittestutils: ReportExpectation.Count must be at least 1, got %d", expected.Count)
+	}
+
+	err := wait.PollImmediateInfiniteWithContext(ctx, time.Second, func(ctx context.Context) (bool, error) {
+		list, err := client.Resource(policyReportGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		for _, report := range list.Items {
+			if matchesExpectation(report, policyName, expected) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("unittestutils: namespace %q never reported %d result(s) for policy %q rule %q: %w", namespace, expected.Count, policyName, expected.Rule, err)
+	}
+
+	return nil
+}
+
+func matchesExpectation(report unstructured.Unstructured, policyName string, expected ReportExpectation) bool {
+	results, found, err := unstructured.NestedSlice(report.Object, "results")
+	if err != nil || !found {
+		return false
+	}
+
+	var count int
+	for _, r := range results {
+		result, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if policy, _, _ := unstructured.NestedString(result, "policy"); policy != policyName {
+			continue
+		}
+		if rule, _, _ := unstructured.NestedString(result, "rule"); rule != expected.Rule {
+			continue
+		}
+		if res, _, _ := unstructured.NestedString(result, "result"); res != expected.Result {
+			continue
+		}
+		if expected.Severity != "" {
+			if severity, _, _ := unstructured.NestedString(result, "severity"); severity != expected.Severity {
+				continue
+			}
+		}
+
+		count++
+	}
+
+	return count >= expected.Count
+}
@@ -0,0 +1,87 @@
+package unittestutils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newPolicyReport(results ...map[string]interface{}) unstructured.Unstructured {
+	items := make([]interface{}, 0, len(results))
+	for _, r := range results {
+		items = append(items, r)
+	}
+
+	return unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "wgpolicyk8s.io/v1alpha2",
+			"kind":       "PolicyReport",
+			"results":    items,
+		},
+	}
+}
+
+func TestMatchesExpectation(t *testing.T) {
+	report := newPolicyReport(
+		map[string]interface{}{"policy": "disallow-privileged", "rule": "validate-privileged", "result": "fail", "severity": "high"},
+		map[string]interface{}{"policy": "disallow-privileged", "rule": "validate-privileged", "result": "pass", "severity": "high"},
+		map[string]interface{}{"policy": "other-policy", "rule": "validate-privileged", "result": "fail", "severity": "high"},
+	)
+
+	cases := []struct {
+		name     string
+		expected ReportExpectation
+		want     bool
+	}{
+		{
+			name:     "matches one failing result",
+			expected: ReportExpectation{Rule: "validate-privileged", Result: "fail", Count: 1},
+			want:     true,
+		},
+		{
+			name:     "does not match other policy's result",
+			expected: ReportExpectation{Rule: "validate-privileged", Result: "fail", Count: 2},
+			want:     false,
+		},
+		{
+			name:     "severity filter excludes non-matching severity",
+			expected: ReportExpectation{Rule: "validate-privileged", Result: "fail", Severity: "low", Count: 1},
+			want:     false,
+		},
+		{
+			name:     "matches one passing result",
+			expected: ReportExpectation{Rule: "validate-privileged", Result: "pass", Count: 1},
+			want:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := matchesExpectation(report, "disallow-privileged", tc.expected); got != tc.want {
+				t.Errorf("matchesExpectation() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMatchesExpectationPassingResultsDoNotSatisfyFailExpectation(t *testing.T) {
+	report := newPolicyReport(
+		map[string]interface{}{"policy": "disallow-privileged", "rule": "validate-privileged", "result": "pass", "severity": "high"},
+	)
+
+	expected := ReportExpectation{Rule: "validate-privileged", Result: "fail", Count: 1}
+	if got := matchesExpectation(report, "disallow-privileged", expected); got {
+		t.Errorf("matchesExpectation() = %v, want false: an all-passing report must not satisfy a fail expectation", got)
+	}
+}
+
+func TestWaitForPolicyReportRejectsZeroCount(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := WaitForPolicyReport(ctx, nil, "tenant1admin", "disallow-privileged", ReportExpectation{Rule: "validate-privileged", Result: "fail"}); err == nil {
+		t.Fatal("WaitForPolicyReport with zero Count = nil error, want error")
+	}
+}
@@ -0,0 +1,108 @@
+package unittestutils
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Rule is a single Kyverno validation rule, as it appears under
+// spec.rules[] in a Policy or ClusterPolicy manifest.
+type Rule map[string]interface{}
+
+// NewTenantPolicy builds a namespaced kyverno.io/v1 Policy scoped to
+// tenantNS, seeded with baseline privileged/hostPath/runAsNonRoot rules
+// plus any extra rules supplied by the caller. Unlike ClusterPolicy, Policy
+// is itself namespaced, so no namespace selector is needed in match.resources:
+// a tenant owns the object directly, and HNC propagates it to the tenant's
+// child namespaces on its own.
+func NewTenantPolicy(tenantNS string, rules ...Rule) *unstructured.Unstructured {
+	all := append(defaultTenantRules(), rules...)
+
+	ruleObjs := make([]interface{}, 0, len(all))
+	for _, r := range all {
+		ruleObjs = append(ruleObjs, map[string]interface{}(r))
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "kyverno.io/v1",
+			"kind":       "Policy",
+			"metadata": map[string]interface{}{
+				"name":      "tenant-baseline",
+				"namespace": tenantNS,
+			},
+			"spec": map[string]interface{}{
+				"validationFailureAction": "enforce",
+				"rules":                   ruleObjs,
+			},
+		},
+	}
+}
+
+// defaultTenantRules are the baseline validation rules every Policy built
+// by NewTenantPolicy carries, covering the same privileged/hostPath/
+// runAsNonRoot checks as the disallow-privileged, disallow-host-path and
+// require-run-as-non-root ClusterPolicy fixtures in Policies.
+func defaultTenantRules() []Rule {
+	return []Rule{
+		{
+			"name": "validate-privileged",
+			"match": map[string]interface{}{
+				"resources": map[string]interface{}{
+					"kinds": []interface{}{"Pod"},
+				},
+			},
+			"validate": map[string]interface{}{
+				"message": "Privileged mode is not allowed. Set privileged to false",
+				"pattern": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"=(securityContext)": map[string]interface{}{
+									"=(privileged)": false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"name": "validate-host-path",
+			"match": map[string]interface{}{
+				"resources": map[string]interface{}{
+					"kinds": []interface{}{"Pod"},
+				},
+			},
+			"validate": map[string]interface{}{
+				"message": "HostPath volumes are forbidden. The field spec.volumes[*].hostPath must be unset",
+				"pattern": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"=(volumes)": []interface{}{
+							map[string]interface{}{
+								"X(hostPath)": "null",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"name": "validate-run-as-non-root",
+			"match": map[string]interface{}{
+				"resources": map[string]interface{}{
+					"kinds": []interface{}{"Pod"},
+				},
+			},
+			"validate": map[string]interface{}{
+				"message": "Running as root is not allowed. Set runAsNonRoot to true",
+				"pattern": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"=(securityContext)": map[string]interface{}{
+							"runAsNonRoot": true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
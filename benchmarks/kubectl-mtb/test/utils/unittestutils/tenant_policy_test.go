@@ -0,0 +1,68 @@
+package unittestutils
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestNewTenantPolicy(t *testing.T) {
+	u := NewTenantPolicy("tenant1admin")
+
+	if kind := u.GetKind(); kind != "Policy" {
+		t.Errorf("kind = %q, want %q", kind, "Policy")
+	}
+	if ns := u.GetNamespace(); ns != "tenant1admin" {
+		t.Errorf("namespace = %q, want %q", ns, "tenant1admin")
+	}
+
+	rules, found, err := unstructured.NestedSlice(u.Object, "spec", "rules")
+	if err != nil || !found {
+		t.Fatalf("spec.rules not found: found=%v err=%v", found, err)
+	}
+
+	wantNames := map[string]bool{
+		"validate-privileged":      false,
+		"validate-host-path":       false,
+		"validate-run-as-non-root": false,
+	}
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		name, _, _ := unstructured.NestedString(rule, "name")
+		if _, ok := wantNames[name]; ok {
+			wantNames[name] = true
+		}
+
+		if _, found, _ := unstructured.NestedStringSlice(rule, "match", "resources", "namespaces"); found {
+			t.Errorf("rule %q has a namespace selector; Policy is already namespaced and should not need one", name)
+		}
+	}
+
+	for name, seen := range wantNames {
+		if !seen {
+			t.Errorf("expected baseline rule %q not found in NewTenantPolicy() output", name)
+		}
+	}
+}
+
+func TestNewTenantPolicyAppendsExtraRules(t *testing.T) {
+	extra := Rule{"name": "validate-custom"}
+
+	u := NewTenantPolicy("tenant1admin", extra)
+
+	rules, _, _ := unstructured.NestedSlice(u.Object, "spec", "rules")
+
+	var found bool
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		if name, _, _ := unstructured.NestedString(rule, "name"); name == "validate-custom" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("custom rule not present in NewTenantPolicy() output")
+	}
+	if len(rules) != len(defaultTenantRules())+1 {
+		t.Errorf("spec.rules has %d entries, want %d", len(rules), len(defaultTenantRules())+1)
+	}
+}